@@ -0,0 +1,37 @@
+// Command capi builds the module parser as a C shared library.
+//
+// Build it with:
+//
+//	go build -buildmode=c-shared -o libgomodule.so ./capi
+//
+// Results are returned as JSON strings and must be released with
+// GoModuleFree. The result schema is defined by the internal/jsonapi
+// package.
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/uudashr/go-module/internal/jsonapi"
+)
+
+// GoModuleParse parses the go.mod text.
+//
+//export GoModuleParse
+func GoModuleParse(text *C.char) *C.char {
+	return C.CString(string(jsonapi.Parse(C.GoString(text))))
+}
+
+// GoModuleFree releases the string returned by this library.
+//
+//export GoModuleFree
+func GoModuleFree(s *C.char) {
+	C.free(unsafe.Pointer(s))
+}
+
+func main() {}
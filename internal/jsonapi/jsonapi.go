@@ -0,0 +1,93 @@
+// Package jsonapi defines the JSON documents returned by the language
+// bindings. The schema is part of the bindings ABI and must stay stable,
+// keep it in sync with the README:
+//
+//	Result:     {"module": Module} or {"error": string}
+//	Module:     {"name": string, "requires": [Package], "excludes": [Package], "replaces": [PackageMap]}
+//	Package:    {"path": string, "version": string}
+//	PackageMap: {"from": Package, "to": Package}
+//
+// Lists are always present, empty lists are encoded as [].
+package jsonapi
+
+import (
+	"encoding/json"
+
+	module "github.com/uudashr/go-module"
+)
+
+// Result of the parse.
+type Result struct {
+	Module *Module `json:"module,omitempty"`
+	Error  string  `json:"error,omitempty"`
+}
+
+// Module represents the mod file.
+type Module struct {
+	Name     string       `json:"name"`
+	Requires []Package    `json:"requires"`
+	Excludes []Package    `json:"excludes"`
+	Replaces []PackageMap `json:"replaces"`
+}
+
+// PackageMap package mapping definition.
+type PackageMap struct {
+	From Package `json:"from"`
+	To   Package `json:"to"`
+}
+
+// Package represents the package info.
+type Package struct {
+	Path    string `json:"path"`
+	Version string `json:"version"`
+}
+
+// Parse the mod file text and returns the encoded Result.
+func Parse(s string) []byte {
+	m, err := module.ParseInString(s)
+	if err != nil {
+		return marshal(Result{Error: err.Error()})
+	}
+
+	return marshal(Result{Module: newModule(m)})
+}
+
+func marshal(r Result) []byte {
+	b, err := json.Marshal(r)
+	if err != nil {
+		b, _ = json.Marshal(Result{Error: err.Error()})
+	}
+
+	return b
+}
+
+func newModule(m *module.Module) *Module {
+	res := &Module{
+		Name:     m.Name,
+		Requires: newPackages(m.Requires),
+		Excludes: newPackages(m.Excludes),
+		Replaces: make([]PackageMap, 0, len(m.Replaces)),
+	}
+
+	for _, r := range m.Replaces {
+		res.Replaces = append(res.Replaces, PackageMap{
+			From: newPackage(r.From),
+			To:   newPackage(r.To),
+		})
+	}
+
+	return res
+}
+
+func newPackages(pkgs []module.Package) []Package {
+	res := make([]Package, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		res = append(res, newPackage(pkg))
+	}
+
+	return res
+}
+
+func newPackage(pkg module.Package) Package {
+	return Package{Path: pkg.Path, Version: pkg.Version}
+}
@@ -0,0 +1,35 @@
+package jsonapi_test
+
+import (
+	"testing"
+
+	"github.com/uudashr/go-module/internal/jsonapi"
+)
+
+func TestParse(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		expect string
+	}{
+		"full": {
+			in:     "module my/thing\nrequire other/thing v1.0.2\nexclude old/thing v1.2.3\nreplace bad/thing v1.4.5 => good/thing v1.4.5\n",
+			expect: `{"module":{"name":"my/thing","requires":[{"path":"other/thing","version":"v1.0.2"}],"excludes":[{"path":"old/thing","version":"v1.2.3"}],"replaces":[{"from":{"path":"bad/thing","version":"v1.4.5"},"to":{"path":"good/thing","version":"v1.4.5"}}]}}`,
+		},
+		"empty lists": {
+			in:     "module my/thing\n",
+			expect: `{"module":{"name":"my/thing","requires":[],"excludes":[],"replaces":[]}}`,
+		},
+		"error": {
+			in:     "bogus",
+			expect: `{"error":"line 1: expect module declaration, got \"bogus\""}`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got, want := string(jsonapi.Parse(tc.in)), tc.expect; got != want {
+				t.Error("got:", got, "want:", want)
+			}
+		})
+	}
+}
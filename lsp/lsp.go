@@ -0,0 +1,193 @@
+// Package lsp provides the building blocks of a go.mod language server.
+//
+// Positions are zero-based, with Character counted in bytes within the
+// line. Callers speaking the Language Server Protocol must convert from
+// the UTF-16 offsets used by the client.
+package lsp
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	module "github.com/uudashr/go-module"
+)
+
+// Position in the file text.
+type Position struct {
+	Line      int // Line number (zero-based)
+	Character int // Byte offset within the line (zero-based)
+}
+
+// Severity of the diagnostic.
+type Severity int
+
+// Severity values, matching the Language Server Protocol.
+const (
+	SeverityError Severity = iota + 1
+	SeverityWarning
+	SeverityInformation
+	SeverityHint
+)
+
+// Diagnostic represents a problem found in the file.
+type Diagnostic struct {
+	Line     int      // Line number (zero-based)
+	Severity Severity // Severity of the problem
+	Message  string   // Problem description
+}
+
+// CompletionItem represents a completion candidate.
+type CompletionItem struct {
+	Label  string // Text to insert
+	Detail string // Short description
+}
+
+var directiveDocs = map[string]string{
+	"module":  "module declares the module path of the main module.",
+	"require": "require declares a module dependency with its minimum version.",
+	"exclude": "exclude prevents a module version from being used.",
+	"replace": "replace substitutes a module version with another module version.",
+}
+
+// Diagnostics of given text.
+func Diagnostics(text string) []Diagnostic {
+	_, err := module.ParseInString(text)
+	if err == nil {
+		return nil
+	}
+
+	perr := err.(*module.ParseError)
+	return []Diagnostic{{
+		Line:     perr.Line - 1,
+		Severity: SeverityError,
+		Message:  perr.Err.Error(),
+	}}
+}
+
+// Hover returns the documentation of the directive at pos, or false if pos
+// is not on a directive keyword.
+func Hover(text string, pos Position) (string, bool) {
+	line, ok := lineAt(text, pos)
+	if !ok {
+		return "", false
+	}
+
+	start, end := pos.Character, pos.Character
+	for start > 0 {
+		r, w := utf8.DecodeLastRuneInString(line[:start])
+		if !isWordRune(r) {
+			break
+		}
+		start -= w
+	}
+
+	for end < len(line) {
+		r, w := utf8.DecodeRuneInString(line[end:])
+		if !isWordRune(r) {
+			break
+		}
+		end += w
+	}
+
+	// directive is always the first token of the line
+	if start != len(line)-len(strings.TrimLeft(line, " \t")) {
+		return "", false
+	}
+
+	doc, ok := directiveDocs[line[start:end]]
+	return doc, ok
+}
+
+// Completions returns the candidates for the word ending at pos. Only
+// directive keywords at the start of a line outside of a block are
+// completed: module until it is declared, the other directives after it.
+func Completions(text string, pos Position) []CompletionItem {
+	line, ok := lineAt(text, pos)
+	if !ok {
+		return nil
+	}
+
+	prefix := strings.TrimLeft(line[:pos.Character], " \t")
+	for _, r := range prefix {
+		if !isWordRune(r) {
+			return nil
+		}
+	}
+
+	moduleLine := -1
+	var inBlock bool
+	for i, l := range strings.Split(text, "\n") {
+		if i == pos.Line {
+			continue
+		}
+
+		l = strings.TrimSpace(l)
+		if moduleLine < 0 && firstWord(l) == "module" {
+			moduleLine = i
+		}
+
+		if i > pos.Line {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(l, ")"):
+			inBlock = false
+		case strings.HasSuffix(l, "("):
+			inBlock = true
+		}
+	}
+
+	if inBlock || moduleLine > pos.Line {
+		return nil
+	}
+
+	var items []CompletionItem
+	for kw, doc := range directiveDocs {
+		if (kw == "module") != (moduleLine < 0) {
+			continue
+		}
+
+		if strings.HasPrefix(kw, prefix) {
+			items = append(items, CompletionItem{Label: kw, Detail: doc})
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Label < items[j].Label
+	})
+	return items
+}
+
+func lineAt(text string, pos Position) (string, bool) {
+	lines := strings.Split(text, "\n")
+	if pos.Line < 0 || pos.Line >= len(lines) {
+		return "", false
+	}
+
+	line := lines[pos.Line]
+	if pos.Character < 0 || pos.Character > len(line) {
+		return "", false
+	}
+
+	return line, true
+}
+
+// firstWord returns the leading keyword or naked value of the trimmed line.
+func firstWord(l string) string {
+	for i, r := range l {
+		if !isWordRune(r) {
+			return l[:i]
+		}
+	}
+
+	return l
+}
+
+// isWordRune reports whether r is part of a keyword or naked value, as
+// accepted by the lexer.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("+-./", r)
+}
@@ -0,0 +1,94 @@
+package lsp_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/uudashr/go-module/lsp"
+)
+
+const modText = `module my/thing
+
+require other/thing v1.0.2
+replace bad/thing v1.4.5 => good/thing v1.4.5
+`
+
+func TestDiagnostics(t *testing.T) {
+	if got := lsp.Diagnostics(modText); len(got) != 0 {
+		t.Error("got:", got, "want: none")
+	}
+
+	in := "module my/thing\n\nrequire other/thing\n"
+	expect := []lsp.Diagnostic{
+		{Line: 2, Severity: lsp.SeverityError, Message: "expect package version, got newline"},
+	}
+
+	if got, want := lsp.Diagnostics(in), expect; !reflect.DeepEqual(got, want) {
+		t.Error("got:", got, "want:", want)
+	}
+}
+
+func TestHover(t *testing.T) {
+	in := modText + "require example.com/replace v1.0.0\n\trequire\nmodule2 my/thing\nrequireé\n"
+	testCases := map[string]struct {
+		pos lsp.Position
+		ok  bool
+	}{
+		"keyword start":    {pos: lsp.Position{Line: 2, Character: 0}, ok: true},
+		"keyword middle":   {pos: lsp.Position{Line: 3, Character: 4}, ok: true},
+		"keyword end":      {pos: lsp.Position{Line: 0, Character: 6}, ok: true},
+		"keyword indent":   {pos: lsp.Position{Line: 5, Character: 2}, ok: true},
+		"package path":     {pos: lsp.Position{Line: 2, Character: 12}, ok: false},
+		"keyword in path":  {pos: lsp.Position{Line: 4, Character: 22}, ok: false},
+		"keyword after =>": {pos: lsp.Position{Line: 3, Character: 30}, ok: false},
+		"keyword suffix":   {pos: lsp.Position{Line: 6, Character: 3}, ok: false},
+		"unicode suffix":   {pos: lsp.Position{Line: 7, Character: 2}, ok: false},
+		"empty line":       {pos: lsp.Position{Line: 1, Character: 0}, ok: false},
+		"out of range":     {pos: lsp.Position{Line: 10, Character: 0}, ok: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			doc, ok := lsp.Hover(in, tc.pos)
+			if got, want := ok, tc.ok; got != want {
+				t.Fatal("got:", got, "want:", want)
+			}
+
+			if ok && doc == "" {
+				t.Error("expect documentation")
+			}
+		})
+	}
+}
+
+func TestCompletions(t *testing.T) {
+	in := "module my/thing\nre\n  \nrequire other/thing v1.0.2\nrequire (\n\t\n)\n\nrequire(\n\t\n)\n"
+	testCases := map[string]struct {
+		in     string
+		pos    lsp.Position
+		labels []string
+	}{
+		"prefix":              {in: in, pos: lsp.Position{Line: 1, Character: 2}, labels: []string{"replace", "require"}},
+		"blank":               {in: in, pos: lsp.Position{Line: 2, Character: 2}, labels: []string{"exclude", "replace", "require"}},
+		"after space":         {in: in, pos: lsp.Position{Line: 3, Character: 10}, labels: nil},
+		"blank line in block": {in: in, pos: lsp.Position{Line: 5, Character: 1}, labels: nil},
+		"after block":         {in: in, pos: lsp.Position{Line: 7, Character: 0}, labels: []string{"exclude", "replace", "require"}},
+		"block without space": {in: in, pos: lsp.Position{Line: 9, Character: 1}, labels: nil},
+		"module line":         {in: in, pos: lsp.Position{Line: 0, Character: 3}, labels: []string{"module"}},
+		"no module":           {in: "\nre\n", pos: lsp.Position{Line: 0, Character: 0}, labels: []string{"module"}},
+		"before module":       {in: "\nre\nmodule a\n", pos: lsp.Position{Line: 1, Character: 2}, labels: nil},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var labels []string
+			for _, item := range lsp.Completions(tc.in, tc.pos) {
+				labels = append(labels, item.Label)
+			}
+
+			if got, want := labels, tc.labels; !reflect.DeepEqual(got, want) {
+				t.Error("got:", got, "want:", want)
+			}
+		})
+	}
+}
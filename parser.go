@@ -24,11 +24,23 @@ type Package struct {
 	Version string // Version (semver)
 }
 
+// ParseError describes a problem found while parsing the mod file.
+type ParseError struct {
+	Line int   // Line number (1-based) where the error occurs
+	Err  error // The actual error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
 // Parse module file from given b.
+//
+// The returned error, if any, is of type *ParseError.
 func Parse(b []byte) (*Module, error) {
 	f := &Module{}
 	l := lex(b)
-	p := &parser{lexer: l, file: f}
+	p := &parser{lexer: l, file: f, line: 1}
 
 	for state := parseModule; state != nil; {
 		state = state(p)
@@ -50,10 +62,17 @@ type parser struct {
 	lexer *lexer
 	file  *Module
 	err   error
+	line  int   // line of the last token
+	last  token // last token read
 }
 
 func (p *parser) nextToken() token {
-	return p.lexer.nextToken()
+	if p.last.kind == tokenNewline {
+		p.line++
+	}
+
+	p.last = p.lexer.nextToken()
+	return p.last
 }
 
 func (p *parser) skipNewline() token {
@@ -70,7 +89,7 @@ func (p *parser) skipNewline() token {
 }
 
 func (p *parser) error(err error) parseFn {
-	p.err = err
+	p.err = &ParseError{Line: p.line, Err: err}
 	return nil
 }
 
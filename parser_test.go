@@ -71,3 +71,37 @@ func TestParse(t *testing.T) {
 		t.Error("got:", got, "want:", want)
 	}
 }
+
+func TestParse_error(t *testing.T) {
+	testCases := map[string]struct {
+		in   string
+		line int
+	}{
+		"no module": {
+			in:   "require other/thing v1.0.2\n",
+			line: 1,
+		},
+		"missing version": {
+			in:   "module my/thing\n\nrequire other/thing\n",
+			line: 3,
+		},
+		"unterminated block": {
+			in:   "module my/thing\nrequire (\n\tother/thing v1.0.2\n",
+			line: 4,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := module.ParseInString(tc.in)
+			perr, ok := err.(*module.ParseError)
+			if !ok {
+				t.Fatalf("expect *module.ParseError, got %T: %v", err, err)
+			}
+
+			if got, want := perr.Line, tc.line; got != want {
+				t.Error("got:", got, "want:", want)
+			}
+		})
+	}
+}
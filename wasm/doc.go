@@ -0,0 +1,13 @@
+//go:build (js && wasm) || wasip1
+// +build js,wasm wasip1
+
+// Command wasm builds the module parser to WebAssembly.
+//
+// On js/wasm it registers a global goModule object with a parse function
+// which accepts the go.mod text and returns the result object.
+//
+// On wasip1 it reads the go.mod text from stdin and writes the result as
+// JSON to stdout.
+//
+// The result schema is defined by the internal/jsonapi package.
+package main
@@ -0,0 +1,29 @@
+//go:build js && wasm
+// +build js,wasm
+
+package main
+
+import (
+	"syscall/js"
+
+	"github.com/uudashr/go-module/internal/jsonapi"
+)
+
+func main() {
+	js.Global().Set("goModule", js.ValueOf(map[string]interface{}{
+		"parse": js.FuncOf(parse),
+	}))
+
+	// keep the exported functions alive
+	select {}
+}
+
+// parse parses the go.mod text given as the first argument. It returns an
+// object holding either the parsed module or the error message.
+func parse(this js.Value, args []js.Value) interface{} {
+	if len(args) < 1 || args[0].Type() != js.TypeString {
+		return map[string]interface{}{"error": "expect go.mod text as argument"}
+	}
+
+	return js.Global().Get("JSON").Call("parse", string(jsonapi.Parse(args[0].String())))
+}
@@ -0,0 +1,22 @@
+//go:build wasip1
+// +build wasip1
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/uudashr/go-module/internal/jsonapi"
+)
+
+func main() {
+	b, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	fmt.Println(string(jsonapi.Parse(string(b))))
+}